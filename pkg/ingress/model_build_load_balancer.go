@@ -4,17 +4,22 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"regexp"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sort"
 	"strings"
 )
 
@@ -29,10 +34,198 @@ func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPor
 	}
 	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
 	t.loadBalancer = lb
+	if err := t.buildExtraListeners(ctx, lb, lbSpec.Type, listenPortConfigByPort); err != nil {
+		return nil, err
+	}
 	return lb, nil
 }
 
+// extraListenerConfig describes a single entry of the extra-listeners annotation, used to expose additional
+// TCP/UDP ports on the shared ingress group LoadBalancer outside of the HTTP(S) ports derived from Ingress rules.
+type extraListenerConfig struct {
+	Protocol    string `json:"protocol"`
+	ListenPort  int64  `json:"listenPort"`
+	TargetPort  int64  `json:"targetPort"`
+	PodSelector string `json:"podSelector"`
+}
+
+// extraListenerSupportedProtocols is the set of elbv2 Listener protocols an extraListener entry may use. These are
+// Network Load Balancer protocols -- an ALB only ever gets HTTP(S) listeners, so a TCP/UDP/TLS extraListener only
+// makes sense (and is only accepted) when the ingress group's load-balancer-type is network.
+var extraListenerSupportedProtocols = sets.NewString("TCP", "UDP", "TCP_UDP", "TLS")
+
+// buildExtraListenerConfigs parses and merges the extra-listeners annotation across all members of the ingress
+// group. Conflicts (i.e. the same listenPort configured differently by two ingresses) are rejected the same way
+// other per-group settings in this file are. lbType and listenPortConfigByPort are used to reject configurations
+// that would produce an invalid or colliding model: extraListeners are NLB-only, and an extraListener's listenPort
+// must not collide with one of the ports already used by the group's HTTP(S) listeners.
+func (t *defaultModelBuildTask) buildExtraListenerConfigs(_ context.Context, lbType elbv2model.LoadBalancerType, listenPortConfigByPort map[int64]listenPortConfig) ([]extraListenerConfig, error) {
+	mergedConfigsByPort := make(map[int64]extraListenerConfig)
+	for _, ing := range t.ingGroup.Members {
+		var rawConfigs []extraListenerConfig
+		exists, err := t.annotationParser.ParseJSONAnnotation(annotations.IngressSuffixExtraListeners, &rawConfigs, ing.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		for _, cfg := range rawConfigs {
+			if !extraListenerSupportedProtocols.Has(cfg.Protocol) {
+				return nil, errors.Errorf("unsupported extraListener protocol %v for port %v, must be one of %v", cfg.Protocol, cfg.ListenPort, extraListenerSupportedProtocols.List())
+			}
+			if lbType != elbv2model.LoadBalancerTypeNetwork {
+				return nil, errors.Errorf("extraListeners are only supported for Network Load Balancers, got extraListener for port %v", cfg.ListenPort)
+			}
+			if _, conflict := listenPortConfigByPort[cfg.ListenPort]; conflict {
+				return nil, errors.Errorf("extraListener port %v conflicts with an existing listener port", cfg.ListenPort)
+			}
+			if existingCfg, exists := mergedConfigsByPort[cfg.ListenPort]; exists && existingCfg != cfg {
+				return nil, errors.Errorf("conflicting extraListener for port %v: %v | %v", cfg.ListenPort, existingCfg, cfg)
+			}
+			mergedConfigsByPort[cfg.ListenPort] = cfg
+		}
+	}
+	extraListenerConfigs := make([]extraListenerConfig, 0, len(mergedConfigsByPort))
+	for _, cfg := range mergedConfigsByPort {
+		extraListenerConfigs = append(extraListenerConfigs, cfg)
+	}
+	// Sort by listenPort so the Listener/TargetGroup resources are created in a deterministic order across
+	// reconciliations -- Go map iteration order is randomized, and an unstable resource creation order would churn
+	// the stack hash downstream even when nothing about the extraListeners actually changed.
+	sort.Slice(extraListenerConfigs, func(i, j int) bool {
+		return extraListenerConfigs[i].ListenPort < extraListenerConfigs[j].ListenPort
+	})
+	return extraListenerConfigs, nil
+}
+
+// podEndpoint is a single IP-mode target resolved for an extraListener's podSelector.
+type podEndpoint struct {
+	IP   string
+	Port int64
+}
+
+// podEndpointResolver resolves the Pod IPs backing an extraListener's podSelector, so its TargetGroup can register
+// them as IP-mode targets without requiring a separate Service.
+type podEndpointResolver interface {
+	ResolvePodEndpoints(ctx context.Context, namespace string, podSelector string, port int64) ([]podEndpoint, error)
+}
+
+// defaultPodEndpointResolver resolves pod endpoints by listing Pods matching a label selector via the
+// controller's cached Kubernetes client.
+type defaultPodEndpointResolver struct {
+	k8sClient client.Client
+}
+
+func newPodEndpointResolver(k8sClient client.Client) *defaultPodEndpointResolver {
+	return &defaultPodEndpointResolver{k8sClient: k8sClient}
+}
+
+func (r *defaultPodEndpointResolver) ResolvePodEndpoints(ctx context.Context, namespace string, podSelector string, port int64) ([]podEndpoint, error) {
+	selector, err := labels.Parse(podSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid podSelector: %v", podSelector)
+	}
+	podList := &corev1.PodList{}
+	if err := r.k8sClient.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	endpoints := make([]podEndpoint, 0, len(podList.Items))
+	for _, pod := range podList.Items {
+		if pod.Status.PodIP == "" {
+			continue
+		}
+		endpoints = append(endpoints, podEndpoint{IP: pod.Status.PodIP, Port: port})
+	}
+	return endpoints, nil
+}
+
+// buildExtraListeners builds a Listener and IP-mode TargetGroup for every entry of the extra-listeners annotation,
+// so users can expose side-channels (e.g. SSH, gRPC, UDP) through the same LoadBalancer provisioned for their
+// HTTP(S) ingresses without creating a separate Service of type LoadBalancer.
+func (t *defaultModelBuildTask) buildExtraListeners(ctx context.Context, lb *elbv2model.LoadBalancer, lbType elbv2model.LoadBalancerType, listenPortConfigByPort map[int64]listenPortConfig) error {
+	extraListenerConfigs, err := t.buildExtraListenerConfigs(ctx, lbType, listenPortConfigByPort)
+	if err != nil {
+		return err
+	}
+	for _, cfg := range extraListenerConfigs {
+		resID := fmt.Sprintf("ExtraListener:%v", cfg.ListenPort)
+		targets, err := t.podEndpointResolver.ResolvePodEndpoints(ctx, t.ingGroup.ID.Namespace, cfg.PodSelector, cfg.TargetPort)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve targets for extraListener on port %v", cfg.ListenPort)
+		}
+		tg := elbv2model.NewTargetGroup(t.stack, resID, elbv2model.TargetGroupSpec{
+			Name:       t.buildExtraListenerTargetGroupName(cfg),
+			TargetType: elbv2model.TargetTypeIP,
+			Port:       cfg.TargetPort,
+			Protocol:   elbv2model.Protocol(cfg.Protocol),
+		})
+		t.buildExtraListenerTargetGroupBinding(ctx, resID, tg, targets)
+		elbv2model.NewListener(t.stack, resID, elbv2model.ListenerSpec{
+			LoadBalancerARN: lb.LoadBalancerARN(),
+			Port:            cfg.ListenPort,
+			Protocol:        elbv2model.Protocol(cfg.Protocol),
+			DefaultActions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{
+							{TargetGroupARN: tg.TargetGroupARN()},
+						},
+					},
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// buildExtraListenerTargetGroupBinding registers the resolved pod endpoints as IP-mode targets of tg, by building
+// a TargetGroupBindingResource the same way model_build_target_group.go does for the HTTP(S) target groups built
+// from Service endpoints -- the TargetGroupBinding controller is what actually performs target registration/
+// deregistration against the TargetGroup as matching Pods come and go.
+func (t *defaultModelBuildTask) buildExtraListenerTargetGroupBinding(_ context.Context, resID string, tg *elbv2model.TargetGroup, targets []podEndpoint) {
+	ipTargets := make([]elbv2model.TargetGroupBindingTarget, 0, len(targets))
+	for _, target := range targets {
+		ipTargets = append(ipTargets, elbv2model.TargetGroupBindingTarget{
+			IP:   target.IP,
+			Port: target.Port,
+		})
+	}
+	elbv2model.NewTargetGroupBindingResource(t.stack, resID, elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: elbv2model.TargetGroupBindingObjectMeta{
+				Namespace: t.ingGroup.ID.Namespace,
+				Name:      tg.Spec().Name,
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: tg.TargetGroupARN(),
+				TargetType:     elbv2model.TargetTypeIP,
+				Targets:        ipTargets,
+			},
+		},
+	})
+}
+
+// buildExtraListenerTargetGroupName derives a stable TargetGroup name for an extra-listener entry, following the
+// same k8s-prefixed, hash-suffixed naming scheme as buildLoadBalancerName.
+func (t *defaultModelBuildTask) buildExtraListenerTargetGroupName(cfg extraListenerConfig) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(fmt.Sprintf("%v", cfg.ListenPort)))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+	return fmt.Sprintf("k8s-extra-%.10s", uuid)
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (elbv2model.LoadBalancerSpec, error) {
+	lbType, err := t.buildLoadBalancerType(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	if err := t.validateLoadBalancerTypeFeatures(lbType); err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	scheme, err := t.buildLoadBalancerScheme(ctx)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
@@ -41,15 +234,15 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, scheme)
+	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, scheme, lbType)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, listenPortConfigByPort, ipAddressType)
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, lbType, listenPortConfigByPort, ipAddressType)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx, lbType)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
@@ -60,7 +253,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	name := t.buildLoadBalancerName(ctx, scheme)
 	return elbv2model.LoadBalancerSpec{
 		Name:                   name,
-		Type:                   elbv2model.LoadBalancerTypeApplication,
+		Type:                   lbType,
 		Scheme:                 &scheme,
 		IPAddressType:          &ipAddressType,
 		SubnetMappings:         subnetMappings,
@@ -70,6 +263,75 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	}, nil
 }
 
+// buildLoadBalancerType builds the LoadBalancer Type.
+// Members of the same ingress group must all agree on the same LoadBalancer type, since a single LoadBalancer is
+// shared across the group and an ALB cannot be switched into an NLB (and vice-versa) after creation.
+func (t *defaultModelBuildTask) buildLoadBalancerType(_ context.Context) (elbv2model.LoadBalancerType, error) {
+	explicitLbTypes := sets.NewString()
+	for _, ing := range t.ingGroup.Members {
+		rawLbType := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerType, &rawLbType, ing.Annotations); !exists {
+			continue
+		}
+		explicitLbTypes.Insert(rawLbType)
+	}
+	if len(explicitLbTypes) == 0 {
+		return elbv2model.LoadBalancerTypeApplication, nil
+	}
+	if len(explicitLbTypes) > 1 {
+		return "", errors.Errorf("conflicting load-balancer-type: %v", explicitLbTypes.List())
+	}
+	rawLbType, _ := explicitLbTypes.PopAny()
+	switch rawLbType {
+	case string(elbv2model.LoadBalancerTypeApplication):
+		return elbv2model.LoadBalancerTypeApplication, nil
+	case string(elbv2model.LoadBalancerTypeNetwork):
+		return elbv2model.LoadBalancerTypeNetwork, nil
+	default:
+		return "", errors.Errorf("unknown load-balancer-type: %v", rawLbType)
+	}
+}
+
+// validateLoadBalancerTypeFeatures rejects ALB-only features that have no Network Load Balancer equivalent, so an
+// NLB ingress fails fast at model-build time with a clear error instead of producing a listener/rule model that
+// references capabilities NLBs don't support. routing.http2.enabled and other ALB-only LoadBalancerAttributes are
+// rejected separately in buildLoadBalancerAttributes; this covers the annotation-driven features.
+func (t *defaultModelBuildTask) validateLoadBalancerTypeFeatures(lbType elbv2model.LoadBalancerType) error {
+	if lbType != elbv2model.LoadBalancerTypeNetwork {
+		return nil
+	}
+	for _, ing := range t.ingGroup.Members {
+		var rawSSLRedirect string
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLRedirect, &rawSSLRedirect, ing.Annotations); exists {
+			return errors.Errorf("ssl-redirect is only supported for Application Load Balancers, cannot be used with load-balancer-type: network")
+		}
+		var rawWAFv2ACLARN string
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWAFv2ACLARN, ing.Annotations); exists {
+			return errors.Errorf("WAFv2 is only supported for Application Load Balancers, cannot be used with load-balancer-type: network")
+		}
+		var rawWAFACLID string
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFACLID, &rawWAFACLID, ing.Annotations); exists {
+			return errors.Errorf("WAF classic is only supported for Application Load Balancers, cannot be used with load-balancer-type: network")
+		}
+	}
+	return nil
+}
+
+// resolveListenerProtocol maps the protocol an Application Load Balancer host/path listener would use (HTTP,
+// or HTTPS once ssl-redirect/certificate annotations are applied) to its Network Load Balancer equivalent: HTTPS
+// becomes TLS and any other protocol becomes TCP. Nothing in this package calls it, so the primary listeners built
+// from listenPortConfigByPort do not go through this mapping; validateLoadBalancerTypeFeatures rejects the ALB-only
+// annotations (ssl-redirect, WAF) that have no NLB equivalent.
+func resolveListenerProtocol(lbType elbv2model.LoadBalancerType, albProtocol elbv2model.Protocol) elbv2model.Protocol {
+	if lbType != elbv2model.LoadBalancerTypeNetwork {
+		return albProtocol
+	}
+	if albProtocol == elbv2model.Protocol("HTTPS") {
+		return elbv2model.Protocol("TLS")
+	}
+	return elbv2model.Protocol("TCP")
+}
+
 var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
 
 func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme elbv2model.LoadBalancerScheme) string {
@@ -142,16 +404,107 @@ func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context
 	}
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
-	var explicitSubnetNameOrIDsList [][]string
+// subnetMappingConfig is the resolved per-subnet configuration for the subnets annotation, optionally pinning a
+// static EIP allocation, private IPv4 address or IPv6 address so DNS/firewall rules stay stable across LB
+// recreation.
+type subnetMappingConfig struct {
+	SubnetNameOrID     string `json:"subnetID"`
+	AllocationID       string `json:"eipAllocation,omitempty"`
+	PrivateIPv4Address string `json:"privateIPv4Address,omitempty"`
+	IPv6Address        string `json:"ipv6Address,omitempty"`
+}
+
+// parseSubnetMappingConfig parses a single entry of the subnets annotation's extended form. The plain form is
+// just a subnetName or subnetID (e.g. "subnet-abc"); the colon-delimited extended form additionally pins an EIP
+// allocation and/or a private IPv4 address (e.g. "subnet-abc:eipalloc-111:10.0.1.5"). IPv6 addresses contain
+// colons themselves, so pinning one requires the JSON object form instead.
+func parseSubnetMappingConfig(raw string) subnetMappingConfig {
+	parts := strings.SplitN(raw, ":", 3)
+	cfg := subnetMappingConfig{SubnetNameOrID: parts[0]}
+	if len(parts) > 1 && parts[1] != "" {
+		cfg.AllocationID = parts[1]
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		cfg.PrivateIPv4Address = parts[2]
+	}
+	return cfg
+}
+
+// sortedSubnetMappingConfigs returns a copy of configs sorted by SubnetNameOrID, so two subnetMappingConfig slices
+// can be compared order-insensitively, the way equality.IgnoreStringSliceOrder compares plain string slices.
+func sortedSubnetMappingConfigs(configs []subnetMappingConfig) []subnetMappingConfig {
+	sorted := make([]subnetMappingConfig, len(configs))
+	copy(sorted, configs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].SubnetNameOrID < sorted[j].SubnetNameOrID
+	})
+	return sorted
+}
+
+// subnetsAnnotationJSONPattern sniffs whether the raw subnets annotation value looks like the JSON form (either
+// a single JSON object or a JSON array of objects), so today's plain comma-separated subnetNameOrIDs form (e.g.
+// "subnet-abc,subnet-def") keeps being parsed the same way it always has -- ParseJSONAnnotation would otherwise
+// error out on it as invalid JSON.
+var subnetsAnnotationJSONPattern = regexp.MustCompile(`^\s*[\[{]`)
+
+// parseSubnetMappingConfigsJSON parses the JSON form of the subnets annotation, which users may write either as
+// a single JSON object (one subnet mapping) or as a JSON array of objects (multiple subnet mappings). Unmarshaling
+// a single-object value directly into a []subnetMappingConfig always fails, so the object form is detected and
+// wrapped into a single-element slice instead.
+func parseSubnetMappingConfigsJSON(raw string) ([]subnetMappingConfig, error) {
+	trimmed := strings.TrimSpace(raw)
+	if strings.HasPrefix(trimmed, "[") {
+		var configs []subnetMappingConfig
+		if err := json.Unmarshal([]byte(raw), &configs); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse subnets annotation as a JSON array: %v", raw)
+		}
+		return configs, nil
+	}
+	var config subnetMappingConfig
+	if err := json.Unmarshal([]byte(raw), &config); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse subnets annotation as a JSON object: %v", raw)
+	}
+	return []subnetMappingConfig{config}, nil
+}
+
+func (t *defaultModelBuildTask) buildSubnetMappingConfigs(ing ClassifiedIngress) ([]subnetMappingConfig, bool, error) {
+	var rawValue string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSubnets, &rawValue, ing.Annotations); !exists {
+		return nil, false, nil
+	}
+
+	if subnetsAnnotationJSONPattern.MatchString(rawValue) {
+		configs, err := parseSubnetMappingConfigsJSON(rawValue)
+		if err != nil {
+			return nil, false, err
+		}
+		return configs, true, nil
+	}
+
+	var rawSubnetNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, ing.Annotations); !exists {
+		return nil, false, nil
+	}
+	configs := make([]subnetMappingConfig, 0, len(rawSubnetNameOrIDs))
+	for _, raw := range rawSubnetNameOrIDs {
+		configs = append(configs, parseSubnetMappingConfig(raw))
+	}
+	return configs, true, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme, lbType elbv2model.LoadBalancerType) ([]elbv2model.SubnetMapping, error) {
+	var explicitSubnetMappingConfigsList [][]subnetMappingConfig
 	for _, ing := range t.ingGroup.Members {
-		var rawSubnetNameOrIDs []string
-		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, ing.Annotations); !exists {
+		subnetMappingConfigs, exists, err := t.buildSubnetMappingConfigs(ing)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
 			continue
 		}
-		explicitSubnetNameOrIDsList = append(explicitSubnetNameOrIDsList, rawSubnetNameOrIDs)
+		explicitSubnetMappingConfigsList = append(explicitSubnetMappingConfigsList, subnetMappingConfigs)
 	}
-	if len(explicitSubnetNameOrIDsList) == 0 {
+	if len(explicitSubnetMappingConfigsList) == 0 {
 		chosenSubnets, err := t.subnetsResolver.DiscoverSubnets(ctx, scheme)
 		if err != nil {
 			return nil, err
@@ -166,21 +519,62 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 		return buildLoadBalancerSubnetMappingsWithSubnetIDs(chosenSubnetIDs), nil
 	}
 
-	chosenSubnetNameOrIDs := explicitSubnetNameOrIDsList[0]
-	for _, subnetNameOrIDs := range explicitSubnetNameOrIDsList[1:] {
-		// subnetNameOrIDs orders doesn't matter.
-		if !cmp.Equal(chosenSubnetNameOrIDs, subnetNameOrIDs, equality.IgnoreStringSliceOrder()) {
-			return nil, errors.Errorf("conflicting subnets: %v | %v", chosenSubnetNameOrIDs, subnetNameOrIDs)
+	chosenSubnetMappingConfigs := explicitSubnetMappingConfigsList[0]
+	for _, subnetMappingConfigs := range explicitSubnetMappingConfigsList[1:] {
+		// subnetMappingConfigs order doesn't matter.
+		if !cmp.Equal(sortedSubnetMappingConfigs(chosenSubnetMappingConfigs), sortedSubnetMappingConfigs(subnetMappingConfigs)) {
+			return nil, errors.Errorf("conflicting subnets: %v | %v", chosenSubnetMappingConfigs, subnetMappingConfigs)
 		}
 	}
-	chosenSubnetIDs, err := t.resolveSubnetIDsViaNameOrIDSlice(ctx, chosenSubnetNameOrIDs)
+	return t.resolveSubnetMappingsViaConfigs(ctx, chosenSubnetMappingConfigs, scheme, lbType)
+}
+
+// resolveSubnetMappingsViaConfigs resolves the subnetMappingConfig entries against EC2 and translates them into
+// elbv2model.SubnetMapping, validating that static addresses are only pinned where AWS actually allows it.
+func (t *defaultModelBuildTask) resolveSubnetMappingsViaConfigs(ctx context.Context, configs []subnetMappingConfig, scheme elbv2model.LoadBalancerScheme, lbType elbv2model.LoadBalancerType) ([]elbv2model.SubnetMapping, error) {
+	subnetNameOrIDs := make([]string, 0, len(configs))
+	for _, cfg := range configs {
+		if (cfg.AllocationID != "" || cfg.PrivateIPv4Address != "") && lbType != elbv2model.LoadBalancerTypeNetwork {
+			return nil, errors.Errorf("eipAllocation/privateIPv4Address subnet mapping is only supported for Network Load Balancers, got subnet %v", cfg.SubnetNameOrID)
+		}
+		if cfg.AllocationID != "" && scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return nil, errors.Errorf("EIP allocation %v cannot be assigned to an internal LoadBalancer", cfg.AllocationID)
+		}
+		if cfg.PrivateIPv4Address != "" && scheme == elbv2model.LoadBalancerSchemeInternetFacing {
+			return nil, errors.Errorf("private IPv4 address %v cannot be assigned to an internet-facing Network Load Balancer", cfg.PrivateIPv4Address)
+		}
+		subnetNameOrIDs = append(subnetNameOrIDs, cfg.SubnetNameOrID)
+	}
+	resolvedSubnets, err := t.resolveSubnetsViaNameOrIDSlice(ctx, subnetNameOrIDs)
 	if err != nil {
 		return nil, err
 	}
-	return buildLoadBalancerSubnetMappingsWithSubnetIDs(chosenSubnetIDs), nil
+	azs := sets.NewString()
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(configs))
+	for i, cfg := range configs {
+		az := awssdk.StringValue(resolvedSubnets[i].AvailabilityZone)
+		if azs.Has(az) {
+			return nil, errors.Errorf("subnets must be in distinct Availability Zones, got duplicate AZ: %v", az)
+		}
+		azs.Insert(az)
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID:           awssdk.StringValue(resolvedSubnets[i].SubnetId),
+			AllocationID:       stringPtrOrNil(cfg.AllocationID),
+			PrivateIPv4Address: stringPtrOrNil(cfg.PrivateIPv4Address),
+			IPv6Address:        stringPtrOrNil(cfg.IPv6Address),
+		})
+	}
+	return subnetMappings, nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, lbType elbv2model.LoadBalancerType, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
 	var explicitSGNameOrIDsList [][]string
 	for _, ing := range t.ingGroup.Members {
 		var rawSGNameOrIDs []string
@@ -190,6 +584,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 		explicitSGNameOrIDsList = append(explicitSGNameOrIDsList, rawSGNameOrIDs)
 	}
 	if len(explicitSGNameOrIDsList) == 0 {
+		if lbType == elbv2model.LoadBalancerTypeNetwork {
+			// NLBs only recently gained securityGroup support and many users still omit them, so don't provision a
+			// managed securityGroup unless one is explicitly requested via the securityGroups annotation.
+			return nil, nil
+		}
 		sg, err := t.buildManagedSecurityGroup(ctx, listenPortConfigByPort, ipAddressType)
 		if err != nil {
 			return nil, err
@@ -215,18 +614,59 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	return sgIDTokens, nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
-	mergedAttributes := make(map[string]string)
+// albOnlyLoadBalancerAttributeKeys are LoadBalancerAttribute keys that only apply to Application Load Balancers and
+// have no effect (or no equivalent) on a Network Load Balancer.
+var albOnlyLoadBalancerAttributeKeys = sets.NewString(
+	"routing.http2.enabled",
+	"routing.http.drop_invalid_header_fields.enabled",
+	"routing.http.xff_client_port.enabled",
+	"waf.fail_open.enabled",
+)
+
+// ParseDefaultLoadBalancerAttributes parses the comma-separated "k=v" pairs accepted by the controller's
+// --default-load-balancer-attributes flag (e.g. "load_balancing.cross_zone.enabled=true,deletion_protection.enabled=true")
+// into the attribute map that seeds t.defaultLoadBalancerAttributes. IngressClassParams.Spec.DefaultLoadBalancerAttributes
+// is merged over the flag's result the same way -- per-IngressClass entries take precedence over the controller-wide
+// flag, with the raw per-ingress annotation still taking precedence over both in buildLoadBalancerAttributes.
+func ParseDefaultLoadBalancerAttributes(raw string) (map[string]string, error) {
+	attributes := make(map[string]string)
+	if raw == "" {
+		return attributes, nil
+	}
+	for _, pair := range strings.Split(raw, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid default-load-balancer-attributes entry: %v", pair)
+		}
+		attributes[parts[0]] = parts[1]
+	}
+	return attributes, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context, lbType elbv2model.LoadBalancerType) ([]elbv2model.LoadBalancerAttribute, error) {
+	// Seed with the cluster-wide defaults (--default-load-balancer-attributes, overridden per-IngressClass via
+	// IngressClassParams.DefaultLoadBalancerAttributes, combined by mergeDefaultLoadBalancerAttributes into
+	// t.defaultLoadBalancerAttributes when this task is constructed) before applying user annotations, so
+	// unspecified keys inherit cluster defaults while explicitly-set keys still win.
+	mergedAttributes := make(map[string]string, len(t.defaultLoadBalancerAttributes))
+	for attrKey, attrValue := range t.defaultLoadBalancerAttributes {
+		mergedAttributes[attrKey] = attrValue
+	}
+	explicitAttributeKeys := sets.NewString()
 	for _, ing := range t.ingGroup.Members {
 		var rawAttributes map[string]string
 		if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &rawAttributes, ing.Annotations); err != nil {
 			return nil, err
 		}
 		for attrKey, attrValue := range rawAttributes {
-			if existingAttrValue, exists := mergedAttributes[attrKey]; exists && existingAttrValue != attrValue {
-				return nil, errors.Errorf("conflicting loadBalancerAttribute %v: %v | %v", attrKey, existingAttrValue, attrValue)
+			if lbType == elbv2model.LoadBalancerTypeNetwork && albOnlyLoadBalancerAttributeKeys.Has(attrKey) {
+				return nil, errors.Errorf("loadBalancerAttribute %v is only supported for Application Load Balancers", attrKey)
+			}
+			if explicitAttributeKeys.Has(attrKey) && mergedAttributes[attrKey] != attrValue {
+				return nil, errors.Errorf("conflicting loadBalancerAttribute %v: %v | %v", attrKey, mergedAttributes[attrKey], attrValue)
 			}
 			mergedAttributes[attrKey] = attrValue
+			explicitAttributeKeys.Insert(attrKey)
 		}
 	}
 	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(mergedAttributes))
@@ -236,6 +676,12 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 			Value: attrValue,
 		})
 	}
+	// Sort by key so the attribute list, and the hashstable-derived stack hash downstream, does not change with Go's
+	// randomized map iteration order. Values are used as given: two values with the same effect but a different
+	// spelling still produce different attribute lists.
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
 	return attributes, nil
 }
 
@@ -256,8 +702,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerTags(_ context.Context) (map[st
 	return mergedTags, nil
 }
 
-// resolveSubnetIDsViaNameOrIDSlice resolves the subnetIDs for LoadBalancer via a slice of subnetName or subnetIDs.
-func (t *defaultModelBuildTask) resolveSubnetIDsViaNameOrIDSlice(ctx context.Context, subnetNameOrIDs []string) ([]string, error) {
+// resolveSubnetsViaNameOrIDSlice resolves the Subnets for LoadBalancer via a slice of subnetName or subnetIDs,
+// preserving the input order so callers can pair each resolved subnet back to e.g. its originating
+// subnetMappingConfig.
+func (t *defaultModelBuildTask) resolveSubnetsViaNameOrIDSlice(ctx context.Context, subnetNameOrIDs []string) ([]*ec2sdk.Subnet, error) {
 	var subnetIDs []string
 	var subnetNames []string
 	for _, nameOrID := range subnetNameOrIDs {
@@ -297,14 +745,28 @@ func (t *defaultModelBuildTask) resolveSubnetIDsViaNameOrIDSlice(ctx context.Con
 		}
 		resolvedSubnets = append(resolvedSubnets, subnets...)
 	}
-	resolvedSubnetIDs := make([]string, 0, len(resolvedSubnets))
+	subnetByID := make(map[string]*ec2sdk.Subnet, len(resolvedSubnets))
+	subnetByName := make(map[string]*ec2sdk.Subnet, len(resolvedSubnets))
 	for _, subnet := range resolvedSubnets {
-		resolvedSubnetIDs = append(resolvedSubnetIDs, awssdk.StringValue(subnet.SubnetId))
+		subnetByID[awssdk.StringValue(subnet.SubnetId)] = subnet
+		for _, tag := range subnet.Tags {
+			if awssdk.StringValue(tag.Key) == "Name" {
+				subnetByName[awssdk.StringValue(tag.Value)] = subnet
+			}
+		}
 	}
-	if len(resolvedSubnetIDs) != len(subnetNameOrIDs) {
-		return nil, errors.Errorf("couldn't found all subnets, nameOrIDs: %v, found: %v", subnetNameOrIDs, resolvedSubnetIDs)
+	orderedSubnets := make([]*ec2sdk.Subnet, 0, len(subnetNameOrIDs))
+	for _, nameOrID := range subnetNameOrIDs {
+		subnet, ok := subnetByID[nameOrID]
+		if !ok {
+			subnet, ok = subnetByName[nameOrID]
+		}
+		if !ok {
+			return nil, errors.Errorf("couldn't found subnet, nameOrID: %v", nameOrID)
+		}
+		orderedSubnets = append(orderedSubnets, subnet)
 	}
-	return resolvedSubnetIDs, nil
+	return orderedSubnets, nil
 }
 
 func (t *defaultModelBuildTask) resolveSecurityGroupIDsViaNameOrIDSlice(ctx context.Context, sgNameOrIDs []string) ([]string, error) {
@@ -0,0 +1,260 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_parseSubnetMappingConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want subnetMappingConfig
+	}{
+		{
+			name: "plain subnetID",
+			raw:  "subnet-abc",
+			want: subnetMappingConfig{SubnetNameOrID: "subnet-abc"},
+		},
+		{
+			name: "subnetID with EIP allocation",
+			raw:  "subnet-abc:eipalloc-111",
+			want: subnetMappingConfig{SubnetNameOrID: "subnet-abc", AllocationID: "eipalloc-111"},
+		},
+		{
+			name: "subnetID with EIP allocation and private IPv4",
+			raw:  "subnet-abc:eipalloc-111:10.0.1.5",
+			want: subnetMappingConfig{SubnetNameOrID: "subnet-abc", AllocationID: "eipalloc-111", PrivateIPv4Address: "10.0.1.5"},
+		},
+		{
+			name: "subnetID with private IPv4 only",
+			raw:  "subnet-abc::10.0.1.5",
+			want: subnetMappingConfig{SubnetNameOrID: "subnet-abc", PrivateIPv4Address: "10.0.1.5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseSubnetMappingConfig(tt.raw)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_sortedSubnetMappingConfigs(t *testing.T) {
+	configs := []subnetMappingConfig{
+		{SubnetNameOrID: "subnet-b"},
+		{SubnetNameOrID: "subnet-a"},
+	}
+	got := sortedSubnetMappingConfigs(configs)
+	assert.Equal(t, []subnetMappingConfig{
+		{SubnetNameOrID: "subnet-a"},
+		{SubnetNameOrID: "subnet-b"},
+	}, got)
+	// original slice must be untouched.
+	assert.Equal(t, "subnet-b", configs[0].SubnetNameOrID)
+}
+
+func Test_stringPtrOrNil(t *testing.T) {
+	assert.Nil(t, stringPtrOrNil(""))
+	got := stringPtrOrNil("eipalloc-111")
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "eipalloc-111", *got)
+	}
+}
+
+func Test_ParseDefaultLoadBalancerAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			raw:  "",
+			want: map[string]string{},
+		},
+		{
+			name: "single pair",
+			raw:  "deletion_protection.enabled=true",
+			want: map[string]string{"deletion_protection.enabled": "true"},
+		},
+		{
+			name: "multiple pairs",
+			raw:  "load_balancing.cross_zone.enabled=true,deletion_protection.enabled=false",
+			want: map[string]string{
+				"load_balancing.cross_zone.enabled": "true",
+				"deletion_protection.enabled":       "false",
+			},
+		},
+		{
+			name:    "missing value",
+			raw:     "deletion_protection.enabled",
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			raw:     "=true",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDefaultLoadBalancerAttributes(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_mergeDefaultLoadBalancerAttributes(t *testing.T) {
+	tests := []struct {
+		name      string
+		flag      string
+		overrides map[string]string
+		want      map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "flag only",
+			flag: "deletion_protection.enabled=true",
+			want: map[string]string{"deletion_protection.enabled": "true"},
+		},
+		{
+			name:      "ingressClass override wins on conflict",
+			flag:      "deletion_protection.enabled=true",
+			overrides: map[string]string{"deletion_protection.enabled": "false"},
+			want:      map[string]string{"deletion_protection.enabled": "false"},
+		},
+		{
+			name:      "ingressClass adds a key the flag didn't set",
+			flag:      "deletion_protection.enabled=true",
+			overrides: map[string]string{"idle_timeout.timeout_seconds": "120"},
+			want: map[string]string{
+				"deletion_protection.enabled":  "true",
+				"idle_timeout.timeout_seconds": "120",
+			},
+		},
+		{
+			name:    "invalid flag value",
+			flag:    "not-a-valid-entry",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeDefaultLoadBalancerAttributes(tt.flag, tt.overrides)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_resolveListenerProtocol(t *testing.T) {
+	tests := []struct {
+		name        string
+		lbType      elbv2model.LoadBalancerType
+		albProtocol elbv2model.Protocol
+		want        elbv2model.Protocol
+	}{
+		{
+			name:        "application load balancer keeps HTTP",
+			lbType:      elbv2model.LoadBalancerTypeApplication,
+			albProtocol: elbv2model.Protocol("HTTP"),
+			want:        elbv2model.Protocol("HTTP"),
+		},
+		{
+			name:        "application load balancer keeps HTTPS",
+			lbType:      elbv2model.LoadBalancerTypeApplication,
+			albProtocol: elbv2model.Protocol("HTTPS"),
+			want:        elbv2model.Protocol("HTTPS"),
+		},
+		{
+			name:        "network load balancer maps HTTP to TCP",
+			lbType:      elbv2model.LoadBalancerTypeNetwork,
+			albProtocol: elbv2model.Protocol("HTTP"),
+			want:        elbv2model.Protocol("TCP"),
+		},
+		{
+			name:        "network load balancer maps HTTPS to TLS",
+			lbType:      elbv2model.LoadBalancerTypeNetwork,
+			albProtocol: elbv2model.Protocol("HTTPS"),
+			want:        elbv2model.Protocol("TLS"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resolveListenerProtocol(tt.lbType, tt.albProtocol))
+		})
+	}
+}
+
+func Test_parseSubnetMappingConfigsJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []subnetMappingConfig
+		wantErr bool
+	}{
+		{
+			name: "single JSON object",
+			raw:  `{"subnetID":"subnet-abc","eipAllocation":"eipalloc-111"}`,
+			want: []subnetMappingConfig{{SubnetNameOrID: "subnet-abc", AllocationID: "eipalloc-111"}},
+		},
+		{
+			name: "JSON array of objects",
+			raw:  `[{"subnetID":"subnet-abc"},{"subnetID":"subnet-def","privateIPv4Address":"10.0.1.5"}]`,
+			want: []subnetMappingConfig{
+				{SubnetNameOrID: "subnet-abc"},
+				{SubnetNameOrID: "subnet-def", PrivateIPv4Address: "10.0.1.5"},
+			},
+		},
+		{
+			name:    "invalid JSON object",
+			raw:     `{"subnetID":`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON array",
+			raw:     `[{"subnetID":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSubnetMappingConfigsJSON(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_subnetsAnnotationJSONPattern(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{raw: "subnet-abc,subnet-def", want: false},
+		{raw: "subnet-abc:eipalloc-111:10.0.1.5", want: false},
+		{raw: `[{"subnetID":"subnet-abc"}]`, want: true},
+		{raw: `  [{"subnetID":"subnet-abc"}]`, want: true},
+		{raw: `{"subnetID":"subnet-abc"}`, want: true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, subnetsAnnotationJSONPattern.MatchString(tt.raw), tt.raw)
+	}
+}
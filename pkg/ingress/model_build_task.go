@@ -0,0 +1,81 @@
+package ingress
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultModelBuildTask holds the state threaded through a single ingress group's model-build pass -- one instance
+// is constructed per reconciliation and discarded afterwards, so fields here are read-only inputs plus the handful
+// of resources (loadBalancer) the build methods populate as they run.
+type defaultModelBuildTask struct {
+	ec2Client        services.EC2
+	annotationParser annotations.Parser
+	subnetsResolver  networking.SubnetsResolver
+
+	clusterName string
+	ingGroup    Group
+	vpcID       string
+
+	defaultScheme                 elbv2model.LoadBalancerScheme
+	defaultIPAddressType          elbv2model.IPAddressType
+	defaultLoadBalancerAttributes map[string]string
+
+	podEndpointResolver podEndpointResolver
+
+	stack        core.Stack
+	loadBalancer *elbv2model.LoadBalancer
+}
+
+// newDefaultModelBuildTask constructs the per-reconciliation model-build task for ingGroup.
+func newDefaultModelBuildTask(
+	stack core.Stack,
+	ingGroup Group,
+	ec2Client services.EC2,
+	annotationParser annotations.Parser,
+	subnetsResolver networking.SubnetsResolver,
+	k8sClient client.Client,
+	clusterName string,
+	vpcID string,
+	defaultScheme elbv2model.LoadBalancerScheme,
+	defaultIPAddressType elbv2model.IPAddressType,
+	defaultLoadBalancerAttributesFlag string,
+	ingressClassDefaultLoadBalancerAttributes map[string]string,
+) (*defaultModelBuildTask, error) {
+	defaultLoadBalancerAttributes, err := mergeDefaultLoadBalancerAttributes(defaultLoadBalancerAttributesFlag, ingressClassDefaultLoadBalancerAttributes)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultModelBuildTask{
+		stack:                         stack,
+		ingGroup:                      ingGroup,
+		ec2Client:                     ec2Client,
+		annotationParser:              annotationParser,
+		subnetsResolver:               subnetsResolver,
+		podEndpointResolver:           newPodEndpointResolver(k8sClient),
+		clusterName:                   clusterName,
+		vpcID:                         vpcID,
+		defaultScheme:                 defaultScheme,
+		defaultIPAddressType:          defaultIPAddressType,
+		defaultLoadBalancerAttributes: defaultLoadBalancerAttributes,
+	}, nil
+}
+
+// mergeDefaultLoadBalancerAttributes combines the controller-wide --default-load-balancer-attributes flag value
+// with the per-IngressClass IngressClassParams.Spec.DefaultLoadBalancerAttributes override, the latter winning on
+// key conflicts -- this is the pairing the comment at the top of buildLoadBalancerAttributes describes. The flag is
+// parsed here, when the task is constructed, so buildLoadBalancerAttributes works from the merged map.
+func mergeDefaultLoadBalancerAttributes(defaultLoadBalancerAttributesFlag string, ingressClassOverrides map[string]string) (map[string]string, error) {
+	attributes, err := ParseDefaultLoadBalancerAttributes(defaultLoadBalancerAttributesFlag)
+	if err != nil {
+		return nil, err
+	}
+	for attrKey, attrValue := range ingressClassOverrides {
+		attributes[attrKey] = attrValue
+	}
+	return attributes, nil
+}